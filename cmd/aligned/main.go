@@ -0,0 +1,27 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/yetanotherco/aligned_layer/pkg/srs"
+)
+
+// main is the entrypoint for the `aligned` CLI, which collects the operator-facing
+// maintenance subcommands (like `aligned srs fetch`) that don't belong to either the
+// operator or verifier long-running services.
+func main() {
+	app := &cli.App{
+		Name:  "aligned",
+		Usage: "Operator-facing maintenance commands for Aligned",
+		Commands: []*cli.Command{
+			srs.FetchCommand(),
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,11 @@
+package common
+
+// DASolution identifies where a proof's bytes are stored, mirroring the DA solution ID
+// the AlignedLayerServiceManager contract tags each VerificationData with.
+type DASolution uint8
+
+const (
+	Calldata DASolution = iota
+	EigenDA
+	Celestia
+)
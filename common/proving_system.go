@@ -0,0 +1,17 @@
+package common
+
+// ProvingSystemId identifies a proof verification backend Aligned supports. It is the
+// wire format both the AlignedLayerServiceManager contract and the standalone verifier
+// service's gRPC/HTTP API use to tag a VerificationData's proof.
+type ProvingSystemId uint16
+
+const (
+	GnarkPlonkBls12_381 ProvingSystemId = iota
+	GnarkPlonkBn254
+	SP1
+	GnarkGroth16Bls12_381
+	GnarkGroth16Bn254
+	// EthStateProof identifies an Ethereum state-proof verification request: a Merkle
+	// proof into an account, storage, or receipt trie rooted at a trusted block hash.
+	EthStateProof
+)
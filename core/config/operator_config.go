@@ -0,0 +1,77 @@
+package config
+
+import (
+	"time"
+
+	"github.com/Layr-Labs/eigenda/api/grpc/disperser"
+	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+	"github.com/celestiaorg/celestia-node/api/rpc/client"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// BaseConfig holds the settings shared by every AVS component (operator, aggregator,
+// backfiller, ...) and handed to core/chainio. It carries only the logger.
+type BaseConfig struct {
+	Logger logging.Logger
+}
+
+// EcdsaConfig holds the operator's ECDSA key, used by core/chainio to sign the
+// transactions an operator sends on-chain (e.g. registration).
+type EcdsaConfig struct {
+	PrivateKeyHex string
+}
+
+// BlsConfig holds the operator's BLS key pair, used to sign task responses sent to the
+// aggregator.
+type BlsConfig struct {
+	KeyPair *bls.KeyPair
+}
+
+// CelestiaConfig configures the Celestia client used to fetch proofs posted to
+// Celestia as their data-availability layer.
+type CelestiaConfig struct {
+	Namespace string
+	Client    *client.Client
+}
+
+// EigenDADisperserConfig configures the EigenDA client used to fetch proofs posted to
+// EigenDA as their data-availability layer.
+type EigenDADisperserConfig struct {
+	Disperser disperser.DisperserClient
+}
+
+// OperatorDetails holds the operator's own identity and its tunable runtime behavior.
+type OperatorDetails struct {
+	Address                       ethcommon.Address
+	AggregatorServerIpPortAddress string
+
+	// BackfillFromBlock is the earliest block the backfiller pages back to on startup
+	// when no further-along state is persisted at BackfillStatePath.
+	BackfillFromBlock uint64
+	// BackfillStatePath is where the backfiller persists its progress, so a restart
+	// resumes from the previous run's high-water mark instead of re-scanning from
+	// BackfillFromBlock every time.
+	BackfillStatePath string
+
+	// MaxConcurrentProofVerifications bounds how many proofs are verified concurrently,
+	// both within a batch and across batches. Values <= 0 fall back to 1.
+	MaxConcurrentProofVerifications int
+	// ProofVerificationTimeout bounds how long a single proof's verification step may
+	// run before it's treated as failed. Values <= 0 fall back to
+	// defaultProofVerificationTimeout.
+	ProofVerificationTimeout time.Duration
+	// BatchVerificationDeadline bounds how long an entire batch's verification may run.
+	// Values <= 0 disable the deadline.
+	BatchVerificationDeadline time.Duration
+}
+
+// OperatorConfig is every setting NewOperatorFromConfig needs to build an Operator.
+type OperatorConfig struct {
+	BaseConfig             BaseConfig
+	EcdsaConfig            EcdsaConfig
+	BlsConfig              BlsConfig
+	CelestiaConfig         CelestiaConfig
+	EigenDADisperserConfig EigenDADisperserConfig
+	Operator               OperatorDetails
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/yetanotherco/aligned_layer/core/config"
+	operatorpkg "github.com/yetanotherco/aligned_layer/operator/pkg"
+)
+
+func main() {
+	operatorAddress := flag.String("operator-address", "", "operator's on-chain address")
+	aggregatorAddress := flag.String("aggregator-address", "0.0.0.0:8090", "address of the aggregator's RPC server")
+	blsKeystorePath := flag.String("bls-keystore-path", "", "path to an encrypted BLS keystore used to sign task responses")
+	blsKeystorePassword := flag.String("bls-keystore-password", "", "password for --bls-keystore-path")
+	backfillFromBlock := flag.Uint64("backfill-from-block", 0, "earliest block the backfiller pages back to on startup when no further-along state is persisted at --backfill-state-path")
+	backfillStatePath := flag.String("backfill-state-path", "operator_backfill_state.json", "path the backfiller persists its progress to, so a restart resumes instead of re-scanning from --backfill-from-block")
+	maxConcurrentProofVerifications := flag.Int("max-concurrent-proof-verifications", 4, "maximum number of proofs verified concurrently")
+	proofVerificationTimeout := flag.Duration("proof-verification-timeout", 30*time.Second, "maximum time a single proof's verification step may run")
+	batchVerificationDeadline := flag.Duration("batch-verification-deadline", 0, "maximum time an entire batch's verification may run; 0 disables the deadline")
+	flag.Parse()
+
+	logger, err := logging.NewZapLogger(logging.Development)
+	if err != nil {
+		log.Fatalf("Could not start logger: %v", err)
+	}
+
+	var blsKeyPair *bls.KeyPair
+	if *blsKeystorePath != "" {
+		blsKeyPair, err = bls.ReadPrivateKeyFromFile(*blsKeystorePath, *blsKeystorePassword)
+		if err != nil {
+			log.Fatalf("Could not read BLS keystore: %v", err)
+		}
+	}
+
+	cfg := config.OperatorConfig{
+		BaseConfig: config.BaseConfig{Logger: logger},
+		BlsConfig:  config.BlsConfig{KeyPair: blsKeyPair},
+		Operator: config.OperatorDetails{
+			Address:                         ethcommon.HexToAddress(*operatorAddress),
+			AggregatorServerIpPortAddress:   *aggregatorAddress,
+			BackfillFromBlock:               *backfillFromBlock,
+			BackfillStatePath:               *backfillStatePath,
+			MaxConcurrentProofVerifications: *maxConcurrentProofVerifications,
+			ProofVerificationTimeout:        *proofVerificationTimeout,
+			BatchVerificationDeadline:       *batchVerificationDeadline,
+		},
+	}
+
+	op, err := operatorpkg.NewOperatorFromConfig(cfg)
+	if err != nil {
+		log.Fatalf("Could not create operator: %v", err)
+	}
+
+	if err := op.Start(context.Background()); err != nil {
+		log.Fatalf("Operator exited with error: %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Layr-Labs/eigensdk-go/logging"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+
+	servicemanager "github.com/yetanotherco/aligned_layer/contracts/bindings/AlignedLayerServiceManager"
+	"github.com/yetanotherco/aligned_layer/core/chainio"
+)
+
+// backfillPageSize bounds how many blocks are requested per historical-logs call, so a
+// long gap in operator uptime doesn't turn into one unbounded RPC request.
+const backfillPageSize = uint64(5_000)
+
+// backfiller pages backwards over historical NewTaskCreated events emitted while the
+// operator was disconnected or restarting, feeding each one through the same
+// ProcessNewTaskCreatedLog -> SignTaskResponse -> SendSignedTaskResponseToAggregator
+// pipeline used for live events. It mirrors the dual syncManager/backfiller pattern used
+// by consensus clients: SubscribeToNewTasks keeps the head in sync while the backfiller
+// catches the operator up on everything it missed.
+type backfiller struct {
+	avsReader   chainio.AvsReader
+	logger      logging.Logger
+	seen        *seenTaskIndices
+	rateLimiter *rate.Limiter
+	lagGauge    prometheus.Gauge
+	fromBlock   uint64
+	statePath   string
+
+	onTask func(*servicemanager.ContractAlignedLayerServiceManagerNewTaskCreated)
+}
+
+func newBackfiller(
+	avsReader chainio.AvsReader,
+	logger logging.Logger,
+	seen *seenTaskIndices,
+	fromBlock uint64,
+	statePath string,
+	lagGauge prometheus.Gauge,
+	onTask func(*servicemanager.ContractAlignedLayerServiceManagerNewTaskCreated),
+) *backfiller {
+	return &backfiller{
+		avsReader: avsReader,
+		logger:    logger,
+		seen:      seen,
+		// A long downtime can produce many historical-log pages; rate-limit them so
+		// backfilling never competes with the live subscription for provider quota.
+		rateLimiter: rate.NewLimiter(rate.Every(500*time.Millisecond), 1),
+		lagGauge:    lagGauge,
+		fromBlock:   fromBlock,
+		statePath:   statePath,
+		onTask:      onTask,
+	}
+}
+
+// Run pages backwards from the current chain head down to the later of b.fromBlock and
+// any block persisted in local state from a previous run, replaying every
+// NewTaskCreated event not already delivered by the live subscription.
+func (b *backfiller) Run(ctx context.Context) error {
+	state, err := loadBackfillState(b.statePath)
+	if err != nil {
+		return fmt.Errorf("could not load backfill state: %w", err)
+	}
+
+	from := b.fromBlock
+	if state.HighWaterMark > from {
+		from = state.HighWaterMark
+	}
+
+	headBlock, err := b.avsReader.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get current block number: %w", err)
+	}
+
+	// A previous run may have crashed partway through its backward scan; resume paging
+	// from its low-water mark instead of re-scanning blocks it already covered. Ignore a
+	// stale low-water mark from before `from` advanced (e.g. --backfill-from-block or
+	// the high-water mark changed since that run).
+	to := headBlock
+	if state.LowWaterMark > from && state.LowWaterMark < to {
+		to = state.LowWaterMark
+	}
+
+	for to > from {
+		if err := b.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		pageFrom := to - backfillPageSize
+		if pageFrom < from {
+			pageFrom = from
+		}
+
+		logs, err := b.avsReader.GetNewTaskCreatedLogs(ctx, pageFrom, to)
+		if err != nil {
+			return fmt.Errorf("could not fetch NewTaskCreated logs [%d, %d]: %w", pageFrom, to, err)
+		}
+
+		for _, taskLog := range logs {
+			if b.seen.markSeen(taskLog.TaskIndex) {
+				continue
+			}
+			b.logger.Infof("Backfilling task with index: %d\n", taskLog.TaskIndex)
+			b.onTask(taskLog)
+			state.LastProcessedTaskIndex = taskLog.TaskIndex
+		}
+
+		to = pageFrom
+		state.LowWaterMark = to
+		if err := state.save(b.statePath); err != nil {
+			b.logger.Errorf("Could not persist backfill state: %v", err)
+		}
+
+		if b.lagGauge != nil {
+			b.lagGauge.Set(float64(to - from))
+		}
+	}
+
+	// The whole [from, headBlock] range is now covered: ratchet the high-water mark up
+	// to headBlock so the next run only scans the gap since this one, and clear the
+	// low-water mark since there's no in-progress scan left to resume.
+	state.HighWaterMark = headBlock
+	state.LowWaterMark = 0
+	if err := state.save(b.statePath); err != nil {
+		b.logger.Errorf("Could not persist backfill state: %v", err)
+	}
+
+	if b.lagGauge != nil {
+		b.lagGauge.Set(0)
+	}
+
+	b.logger.Info("Backfiller caught up with chain head")
+	return nil
+}
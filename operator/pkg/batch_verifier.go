@@ -0,0 +1,198 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/yetanotherco/aligned_layer/common"
+	servicemanager "github.com/yetanotherco/aligned_layer/contracts/bindings/AlignedLayerServiceManager"
+	verifierpkg "github.com/yetanotherco/aligned_layer/verifier/pkg"
+)
+
+// defaultProofVerificationTimeout bounds how long a single proof's verification step
+// may run when OperatorConfig doesn't set one, so a malformed proof can't indefinitely
+// block a worker.
+const defaultProofVerificationTimeout = 30 * time.Second
+
+var (
+	fetchLatencySeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "aligned_operator",
+		Name:      "da_fetch_latency_seconds",
+		Help:      "Time spent fetching a proof from its data-availability layer, by proving system ID.",
+	}, []string{"proving_system_id"})
+
+	verifyLatencySeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "aligned_operator",
+		Name:      "proof_verify_latency_seconds",
+		Help:      "Time spent verifying a proof, by proving system ID.",
+	}, []string{"proving_system_id"})
+)
+
+// fetchedProof carries a single proof's DA payload once it has been resolved to raw
+// bytes, tagged with its original index so the verify stage can write its result back
+// into the batch's result slice in order.
+type fetchedProof struct {
+	index           int
+	proof           []byte
+	pubInput        []byte
+	verificationKey []byte
+	provingSystemId uint16
+	fetchErr        error
+}
+
+// verifyBatch fans task's proofs out across a bounded pool of worker goroutines
+// (sized by o.Config.Operator.MaxConcurrentProofVerifications, via o.verifySem) instead
+// of verifying them one at a time. A fetch stage resolves each proof's DA payload and
+// pushes it onto a channel as soon as it's ready, so fetching proof i+1 overlaps
+// verification of proof i rather than waiting for the whole batch to be fetched
+// upfront. Each proof also gets its own verification timeout, so one slow or malformed
+// proof occupies a single worker instead of stalling the rest of the batch: verifyBatch
+// itself returns as soon as every proof has either verified or timed out, but a
+// timed-out proof's worker slot in o.verifySem is only released once the underlying
+// gnark/sp1 call actually returns, since that call can't be interrupted mid-computation.
+func (o *Operator) verifyBatch(ctx context.Context, task servicemanager.AlignedLayerServiceManagerBatchProofVerificationTask) []bool {
+	numProofs := len(task.ProofVerificationsData)
+	results := make([]bool, numProofs)
+
+	if o.Config.Operator.BatchVerificationDeadline > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Config.Operator.BatchVerificationDeadline)
+		defer cancel()
+	}
+
+	fetchedCh := make(chan fetchedProof, numProofs)
+	go func() {
+		defer close(fetchedCh)
+		for i, verificationData := range task.ProofVerificationsData {
+			select {
+			case <-ctx.Done():
+				// The deadline already passed: don't start any more DA fetches, just
+				// record the remaining proofs as failed so fetchedCh still closes and
+				// verifyBatch returns instead of waiting on fetches that will never run.
+				fetchedCh <- fetchedProof{index: i, provingSystemId: verificationData.ProvingSystemId, fetchErr: ctx.Err()}
+			default:
+				fetchedCh <- o.fetchProof(ctx, i, verificationData)
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for fetched := range fetchedCh {
+		fetched := fetched
+		o.verifySem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[fetched.index] = o.verifyFetchedProof(ctx, fetched)
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// fetchProof resolves a single proof's DA payload to raw bytes and records fetch
+// latency for that proving system. The DA client call runs in its own goroutine and is
+// raced against ctx, so a DA layer that hangs (or ignores the context it's handed)
+// can't block the producer goroutine in verifyBatch from moving on to the next proof or
+// closing fetchedCh once the batch deadline passes.
+func (o *Operator) fetchProof(ctx context.Context, index int, verificationData servicemanager.AlignedLayerServiceManagerVerificationData) fetchedProof {
+	start := time.Now()
+
+	type fetchResult struct {
+		proof []byte
+		err   error
+	}
+	resultCh := make(chan fetchResult, 1)
+	go func() {
+		var proof []byte
+		var err error
+
+		switch verificationData.DAPayload.Solution {
+		case common.Calldata:
+			proof = verificationData.DAPayload.ProofAssociatedData
+		case common.EigenDA:
+			proof, err = o.getProofFromEigenDA(ctx, verificationData.DAPayload.ProofAssociatedData, verificationData.DAPayload.Index)
+		case common.Celestia:
+			proof, err = o.getProofFromCelestia(ctx, verificationData.DAPayload.Index, o.Config.CelestiaConfig.Namespace, verificationData.DAPayload.ProofAssociatedData)
+		}
+		resultCh <- fetchResult{proof: proof, err: err}
+	}()
+
+	base := fetchedProof{
+		index:           index,
+		pubInput:        verificationData.PubInput,
+		verificationKey: verificationData.VerificationKey,
+		provingSystemId: verificationData.ProvingSystemId,
+	}
+
+	select {
+	case <-ctx.Done():
+		base.fetchErr = fmt.Errorf("fetching proof %d from DA: %w", index, ctx.Err())
+		return base
+	case res := <-resultCh:
+		fetchLatencySeconds.WithLabelValues(strconv.Itoa(int(verificationData.ProvingSystemId))).Observe(time.Since(start).Seconds())
+		base.proof = res.proof
+		base.fetchErr = res.err
+		return base
+	}
+}
+
+// verifyFetchedProof verifies a single already-fetched proof under a per-proof timeout
+// and records verify latency for that proving system. The caller must have already
+// acquired a slot in o.verifySem for this call; verifyFetchedProof releases it once the
+// underlying verification goroutine truly finishes, which on a timeout happens after
+// this function has already returned its result, since gnark/sp1 verification can't be
+// cancelled mid-computation.
+func (o *Operator) verifyFetchedProof(ctx context.Context, fetched fetchedProof) bool {
+	if fetched.fetchErr != nil {
+		o.Logger.Errorf("Could not fetch proof %d: %v", fetched.index, fetched.fetchErr)
+		<-o.verifySem
+		return false
+	}
+
+	timeout := o.Config.Operator.ProofVerificationTimeout
+	if timeout <= 0 {
+		timeout = defaultProofVerificationTimeout
+	}
+	proofCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	label := strconv.Itoa(int(fetched.provingSystemId))
+	start := time.Now()
+
+	verifiedCh := make(chan bool, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		defer func() { <-o.verifySem }()
+
+		verified, err := o.verifier.Verify(verifierpkg.VerificationData{
+			ProvingSystemId: common.ProvingSystemId(fetched.provingSystemId),
+			Proof:           fetched.proof,
+			PubInput:        fetched.pubInput,
+			VerificationKey: fetched.verificationKey,
+		})
+		if err != nil {
+			errCh <- err
+			return
+		}
+		verifiedCh <- verified
+	}()
+
+	select {
+	case <-proofCtx.Done():
+		o.Logger.Errorf("Proof %d timed out during verification: %v", fetched.index, proofCtx.Err())
+		return false
+	case err := <-errCh:
+		o.Logger.Error(err.Error())
+		return false
+	case verified := <-verifiedCh:
+		verifyLatencySeconds.WithLabelValues(label).Observe(time.Since(start).Seconds())
+		o.Logger.Infof("Proof verification result: %t", verified)
+		return verified
+	}
+}
@@ -1,7 +1,6 @@
 package operator
 
 import (
-	"bytes"
 	"context"
 	"crypto/ecdsa"
 	"fmt"
@@ -9,22 +8,19 @@ import (
 	"time"
 
 	"github.com/celestiaorg/celestia-node/api/rpc/client"
-	"github.com/yetanotherco/aligned_layer/operator/sp1"
 
 	"github.com/Layr-Labs/eigenda/api/grpc/disperser"
 	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
 	"github.com/Layr-Labs/eigensdk-go/logging"
 	eigentypes "github.com/Layr-Labs/eigensdk-go/types"
-	"github.com/consensys/gnark-crypto/ecc"
-	"github.com/consensys/gnark/backend/plonk"
-	"github.com/consensys/gnark/backend/witness"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/event"
-	"github.com/yetanotherco/aligned_layer/common"
+	"github.com/prometheus/client_golang/prometheus"
 	servicemanager "github.com/yetanotherco/aligned_layer/contracts/bindings/AlignedLayerServiceManager"
 	"github.com/yetanotherco/aligned_layer/core/chainio"
 	"github.com/yetanotherco/aligned_layer/core/types"
 	"github.com/yetanotherco/aligned_layer/core/utils"
+	verifierpkg "github.com/yetanotherco/aligned_layer/verifier/pkg"
 	"golang.org/x/crypto/sha3"
 
 	"github.com/yetanotherco/aligned_layer/core/config"
@@ -38,12 +34,21 @@ type Operator struct {
 	PrivKey            *ecdsa.PrivateKey
 	KeyPair            *bls.KeyPair
 	OperatorId         eigentypes.OperatorId
+	avsReader          chainio.AvsReader
 	avsSubscriber      chainio.AvsSubscriber
 	NewTaskCreatedChan chan *servicemanager.ContractAlignedLayerServiceManagerNewTaskCreated
 	Logger             logging.Logger
 	aggRpcClient       AggregatorRpcClient
 	disperser          disperser.DisperserClient
 	celestiaClient     *client.Client
+	verifier           *verifierpkg.Verifier
+	seenTasks          *seenTaskIndices
+	backfillLagGauge   prometheus.Gauge
+	// verifySem bounds how many proof verifications run concurrently across the
+	// operator's whole lifetime (not just within a single batch), so a verification
+	// goroutine still running past its batch's deadline keeps occupying a slot instead
+	// of letting later batches spawn unbounded additional verifiers.
+	verifySem chan struct{}
 	//Socket  string
 	//Timeout time.Duration
 }
@@ -78,9 +83,16 @@ func NewOperatorFromConfig(configuration config.OperatorConfig) (*Operator, erro
 
 	operatorId := eigentypes.OperatorIdFromKeyPair(configuration.BlsConfig.KeyPair)
 	address := configuration.Operator.Address
+
+	verifyPoolSize := configuration.Operator.MaxConcurrentProofVerifications
+	if verifyPoolSize <= 0 {
+		verifyPoolSize = 1
+	}
+
 	operator := &Operator{
 		Config:             configuration,
 		Logger:             logger,
+		avsReader:          *avsReader,
 		avsSubscriber:      *avsSubscriber,
 		Address:            address,
 		NewTaskCreatedChan: newTaskCreatedChan,
@@ -88,6 +100,14 @@ func NewOperatorFromConfig(configuration config.OperatorConfig) (*Operator, erro
 		OperatorId:         operatorId,
 		disperser:          configuration.EigenDADisperserConfig.Disperser,
 		celestiaClient:     configuration.CelestiaConfig.Client,
+		verifier:           verifierpkg.NewVerifier(),
+		seenTasks:          newSeenTaskIndices(),
+		verifySem:          make(chan struct{}, verifyPoolSize),
+		backfillLagGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "aligned_operator",
+			Name:      "backfill_lag_blocks",
+			Help:      "Number of blocks the backfiller still has left to scan before it has caught up.",
+		}),
 		// Timeout
 		// Socket
 	}
@@ -100,8 +120,37 @@ func (o *Operator) SubscribeToNewTasks() event.Subscription {
 	return sub
 }
 
+// StartBackfill runs the backfiller to completion, replaying every NewTaskCreated event
+// emitted while the operator was disconnected or restarting. Start already runs it in
+// the background right after subscribing to live events, so the operator doesn't miss
+// tasks created during a restart window.
+func (o *Operator) StartBackfill(ctx context.Context) error {
+	b := newBackfiller(
+		o.avsReader,
+		o.Logger,
+		o.seenTasks,
+		o.Config.Operator.BackfillFromBlock,
+		o.Config.Operator.BackfillStatePath,
+		o.backfillLagGauge,
+		o.handleNewTask,
+	)
+	return b.Run(ctx)
+}
+
 func (o *Operator) Start(ctx context.Context) error {
+	// Subscribe before running the backfill, not after: StartBackfill only covers
+	// blocks up to the headBlock it snapshots at the start of its run, so anything
+	// emitted between that snapshot and whenever the subscription would otherwise start
+	// would be missed by both paths. seenTasks.markSeen dedupes the overlap, whichever
+	// path observes a given task first.
 	sub := o.SubscribeToNewTasks()
+
+	go func() {
+		if err := o.StartBackfill(ctx); err != nil {
+			o.Logger.Errorf("could not backfill missed tasks: %v", err)
+		}
+	}()
+
 	for {
 		select {
 		case <-context.Background().Done():
@@ -112,23 +161,34 @@ func (o *Operator) Start(ctx context.Context) error {
 			sub.Unsubscribe()
 			sub = o.SubscribeToNewTasks()
 		case newTaskCreatedLog := <-o.NewTaskCreatedChan:
-			o.Logger.Infof("Received task with index: %d\n", newTaskCreatedLog.TaskIndex)
-			taskResponse := o.ProcessNewTaskCreatedLog(newTaskCreatedLog)
-			responseSignature, err := o.SignTaskResponse(taskResponse)
-			if err != nil {
-				o.Logger.Errorf("Could not sign task response", "err", err)
+			if o.seenTasks.markSeen(newTaskCreatedLog.TaskIndex) {
+				o.Logger.Infof("Skipping task with index %d, already processed by backfiller\n", newTaskCreatedLog.TaskIndex)
+				continue
 			}
+			o.handleNewTask(newTaskCreatedLog)
+		}
+	}
+}
 
-			signedTaskResponse := types.SignedTaskResponse{
-				TaskResponse: *taskResponse,
-				BlsSignature: *responseSignature,
-				OperatorId:   o.OperatorId,
-			}
+// handleNewTask runs a single NewTaskCreated event through verification, signing and
+// submission to the aggregator. It is shared by the live subscription in Start and by
+// the backfiller, so both paths process a task identically.
+func (o *Operator) handleNewTask(newTaskCreatedLog *servicemanager.ContractAlignedLayerServiceManagerNewTaskCreated) {
+	o.Logger.Infof("Received task with index: %d\n", newTaskCreatedLog.TaskIndex)
+	taskResponse := o.ProcessNewTaskCreatedLog(newTaskCreatedLog)
+	responseSignature, err := o.SignTaskResponse(taskResponse)
+	if err != nil {
+		o.Logger.Errorf("Could not sign task response", "err", err)
+	}
 
-			o.Logger.Infof("Signed hash: %+v", *responseSignature)
-			go o.aggRpcClient.SendSignedTaskResponseToAggregator(&signedTaskResponse)
-		}
+	signedTaskResponse := types.SignedTaskResponse{
+		TaskResponse: *taskResponse,
+		BlsSignature: *responseSignature,
+		OperatorId:   o.OperatorId,
 	}
+
+	o.Logger.Infof("Signed hash: %+v", *responseSignature)
+	go o.aggRpcClient.SendSignedTaskResponseToAggregator(&signedTaskResponse)
 }
 
 // Takes a NewTaskCreatedLog struct as input and returns a TaskResponseHeader struct.
@@ -144,71 +204,7 @@ func (o *Operator) ProcessNewTaskCreatedLog(newTaskCreatedLog *servicemanager.Co
 		"task created block", task.TaskCreatedBlock,
 	)
 
-	var err error
-	proofVerificationResults := make([]bool, numProofs)
-
-	// Iterate over every proof and verify
-	for i, verificationData := range task.ProofVerificationsData {
-		var proof []byte
-
-		switch verificationData.DAPayload.Solution {
-		case common.Calldata:
-			proof = verificationData.DAPayload.ProofAssociatedData
-		case common.EigenDA:
-			proof, err = o.getProofFromEigenDA(verificationData.DAPayload.ProofAssociatedData, verificationData.DAPayload.Index)
-			if err != nil {
-				o.Logger.Errorf("Could not get proof from EigenDA: %v", err)
-				return nil
-			}
-		case common.Celestia:
-			proof, err = o.getProofFromCelestia(verificationData.DAPayload.Index, o.Config.CelestiaConfig.Namespace, verificationData.DAPayload.ProofAssociatedData)
-			if err != nil {
-				o.Logger.Errorf("Could not get proof from Celestia: %v", err)
-				return nil
-			}
-		}
-
-		proofLen := (uint)(len(proof))
-		pubInput := verificationData.PubInput
-		provingSystemId := verificationData.ProvingSystemId
-
-		switch provingSystemId {
-		case uint16(common.GnarkPlonkBls12_381):
-			verificationKey := verificationData.VerificationKey
-			verificationResult := o.verifyPlonkProofBLS12_381(proof, pubInput, verificationKey)
-
-			o.Logger.Infof("PLONK BLS12_381 proof verification result: %t", verificationResult)
-
-			proofVerificationResults[i] = verificationResult
-
-		case uint16(common.GnarkPlonkBn254):
-			verificationKey := verificationData.VerificationKey
-			verificationResult := o.verifyPlonkProofBN254(proof, pubInput, verificationKey)
-
-			o.Logger.Infof("PLONK BN254 proof verification result: %t", verificationResult)
-
-			proofVerificationResults[i] = verificationResult
-
-		case uint16(common.SP1):
-			proofBytes := make([]byte, sp1.MaxProofSize)
-			copy(proofBytes, proof)
-
-			elf := verificationData.PubInput
-			elfBytes := make([]byte, sp1.MaxElfBufferSize)
-			copy(elfBytes, elf)
-			elfLen := (uint)(len(elf))
-
-			verificationResult := sp1.VerifySp1Proof(([sp1.MaxProofSize]byte)(proofBytes), proofLen, ([sp1.MaxElfBufferSize]byte)(elfBytes), elfLen)
-
-			o.Logger.Infof("SP1 proof verification result: %t", verificationResult)
-
-			proofVerificationResults[i] = verificationResult
-
-		default:
-			o.Logger.Error("Unrecognized proving system ID")
-			return nil
-		}
-	}
+	proofVerificationResults := o.verifyBatch(context.Background(), task)
 
 	taskResponse := &servicemanager.AlignedLayerServiceManagerBatchProofVerificationTaskResponse{
 		TaskIndex:    newTaskCreatedLog.TaskIndex,
@@ -218,47 +214,6 @@ func (o *Operator) ProcessNewTaskCreatedLog(newTaskCreatedLog *servicemanager.Co
 
 }
 
-// VerifyPlonkProofBLS12_381 verifies a BLS12-381 PLONK proof
-func (o *Operator) verifyPlonkProofBLS12_381(proofBytes []byte, pubInputBytes []byte, verificationKeyBytes []byte) bool {
-	return o.verifyPlonkProof(proofBytes, pubInputBytes, verificationKeyBytes, ecc.BLS12_381)
-}
-
-// VerifyPlonkProofBN254 verifies a BN254 PLONK proof
-func (o *Operator) verifyPlonkProofBN254(proofBytes []byte, pubInputBytes []byte, verificationKeyBytes []byte) bool {
-	return o.verifyPlonkProof(proofBytes, pubInputBytes, verificationKeyBytes, ecc.BN254)
-}
-
-// verifyPlonkProof contains the common proof verification logic.
-func (o *Operator) verifyPlonkProof(proofBytes []byte, pubInputBytes []byte, verificationKeyBytes []byte, curve ecc.ID) bool {
-	proofReader := bytes.NewReader(proofBytes)
-	proof := plonk.NewProof(curve)
-	if _, err := proof.ReadFrom(proofReader); err != nil {
-		o.Logger.Errorf("Could not deserialize proof: %v", err)
-		return false
-	}
-
-	pubInputReader := bytes.NewReader(pubInputBytes)
-	pubInput, err := witness.New(curve.ScalarField())
-	if err != nil {
-		o.Logger.Errorf("Error instantiating witness: %v", err)
-		return false
-	}
-	if _, err = pubInput.ReadFrom(pubInputReader); err != nil {
-		o.Logger.Errorf("Could not read PLONK public input: %v", err)
-		return false
-	}
-
-	verificationKeyReader := bytes.NewReader(verificationKeyBytes)
-	verificationKey := plonk.NewVerifyingKey(curve)
-	if _, err = verificationKey.ReadFrom(verificationKeyReader); err != nil {
-		o.Logger.Errorf("Could not read PLONK verifying key from bytes: %v", err)
-		return false
-	}
-
-	err = plonk.Verify(proof, verificationKey, pubInput)
-	return err == nil
-}
-
 func (o *Operator) SignTaskResponse(taskResponse *servicemanager.AlignedLayerServiceManagerBatchProofVerificationTaskResponse) (*bls.Signature, error) {
 	encodedResponseBytes, err := utils.AbiEncodeTaskResponse(*taskResponse)
 	if err != nil {
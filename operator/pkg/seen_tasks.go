@@ -0,0 +1,28 @@
+package operator
+
+import "sync"
+
+// seenTaskIndices deduplicates NewTaskCreated events observed by both the live
+// websocket subscription and the backfiller, so a task index that both paths
+// deliver is only ever processed once.
+type seenTaskIndices struct {
+	mu   sync.Mutex
+	seen map[uint32]struct{}
+}
+
+func newSeenTaskIndices() *seenTaskIndices {
+	return &seenTaskIndices{seen: make(map[uint32]struct{})}
+}
+
+// markSeen records taskIndex as seen and reports whether it had already been seen
+// before this call.
+func (s *seenTaskIndices) markSeen(taskIndex uint32) (alreadySeen bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.seen[taskIndex]; ok {
+		return true
+	}
+	s.seen[taskIndex] = struct{}{}
+	return false
+}
@@ -0,0 +1,90 @@
+package srs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/urfave/cli/v2"
+)
+
+// FetchCommand returns the `srs fetch` subcommand, meant to be registered under the
+// `aligned` CLI's command list so operators can warm the local SRS cache ahead of time
+// instead of paying for the download on a circuit's first proof.
+func FetchCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "srs",
+		Usage: "Manage the local KZG SRS cache",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "fetch",
+				Usage: "Download and cache a KZG SRS for the given curve and degree",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "curve", Required: true, Usage: "bn254 or bls12-381"},
+					&cli.IntFlag{Name: "max-degree", Required: true, Usage: "minimum number of SRS elements to fetch"},
+					&cli.StringFlag{Name: "cache-dir", Value: "~/.aligned/srs", Usage: "directory the SRS cache is stored in"},
+					&cli.StringFlag{Name: "srs-url", Value: "https://srs.alignedlayer.com", Usage: "base URL of the ceremony-sourced SRS file server"},
+					&cli.StringFlag{Name: "manifest-path", Required: true, Usage: "path to the JSON manifest pinning each SRS file's SHA-256 digest"},
+				},
+				Action: fetchAction,
+			},
+		},
+	}
+}
+
+func fetchAction(c *cli.Context) error {
+	curve, err := curveFromFlag(c.String("curve"))
+	if err != nil {
+		return err
+	}
+
+	manifest, err := loadManifest(c.String("manifest-path"))
+	if err != nil {
+		return fmt.Errorf("could not load SRS manifest: %w", err)
+	}
+
+	cacheDir, err := expandHome(c.String("cache-dir"))
+	if err != nil {
+		return fmt.Errorf("could not resolve --cache-dir: %w", err)
+	}
+
+	cache := NewFileCache(cacheDir, NewHTTPFetcher(c.String("srs-url"), manifest))
+
+	data, err := cache.Fetch(c.Context, curve, c.Int("max-degree"))
+	if err != nil {
+		return fmt.Errorf("could not fetch SRS: %w", err)
+	}
+
+	fmt.Printf("Cached %d-byte SRS for %s at degree %d in %s\n", len(data), curve.String(), c.Int("max-degree"), cacheDir)
+	return nil
+}
+
+// expandHome resolves a leading "~" in path to the current user's home directory,
+// since the flag package (and urfave/cli on top of it) never does this itself: left
+// unresolved, a default like "~/.aligned/srs" would be created literally as a
+// directory named "~" under the current working directory.
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("could not resolve home directory: %w", err)
+	}
+
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
+
+func curveFromFlag(name string) (ecc.ID, error) {
+	switch name {
+	case "bn254":
+		return ecc.BN254, nil
+	case "bls12-381":
+		return ecc.BLS12_381, nil
+	default:
+		return 0, fmt.Errorf("unsupported curve %q", name)
+	}
+}
@@ -0,0 +1,150 @@
+package srs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/consensys/gnark-crypto/ecc"
+)
+
+// Fetcher supplies the raw, gnark-encoded KZG SRS bytes for a curve at a minimum
+// degree, from whatever backs it (a local cache, a ceremony file server, ...).
+type Fetcher interface {
+	Fetch(ctx context.Context, curve ecc.ID, maxDegree int) ([]byte, error)
+}
+
+// FileCache is a Fetcher backed by a local directory, keyed by curve and max degree, so
+// an SRS fetched once is reused across process restarts instead of being regenerated
+// or redownloaded every time.
+type FileCache struct {
+	Dir  string
+	Next Fetcher
+}
+
+func NewFileCache(dir string, next Fetcher) *FileCache {
+	return &FileCache{Dir: dir, Next: next}
+}
+
+func (c *FileCache) path(curve ecc.ID, maxDegree int) string {
+	return filepath.Join(c.Dir, fmt.Sprintf("%s-%d.srs", curve.String(), maxDegree))
+}
+
+// Fetch returns the cached SRS for (curve, maxDegree) if present, otherwise fetches it
+// from c.Next and writes it to the cache before returning it.
+func (c *FileCache) Fetch(ctx context.Context, curve ecc.ID, maxDegree int) ([]byte, error) {
+	path := c.path(curve, maxDegree)
+	if data, err := os.ReadFile(path); err == nil {
+		return data, nil
+	}
+
+	data, err := c.Next.Fetch(ctx, curve, maxDegree)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(c.Dir, 0755); err != nil {
+		return nil, fmt.Errorf("could not create SRS cache directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return nil, fmt.Errorf("could not write SRS to cache: %w", err)
+	}
+
+	return data, nil
+}
+
+// ManifestEntry pins the expected SHA-256 digest of a ceremony-sourced SRS file, so an
+// HTTPFetcher can verify the file it downloads hasn't been tampered with in transit or
+// by a compromised file server.
+type ManifestEntry struct {
+	Curve     string `json:"curve"`
+	MaxDegree int    `json:"maxDegree"`
+	Sha256    string `json:"sha256"`
+}
+
+// HTTPFetcher downloads a ceremony-sourced SRS from BaseURL and verifies its SHA-256
+// against a pinned Manifest entry before returning it.
+type HTTPFetcher struct {
+	BaseURL  string
+	Manifest []ManifestEntry
+	Client   *http.Client
+}
+
+func NewHTTPFetcher(baseURL string, manifest []ManifestEntry) *HTTPFetcher {
+	return &HTTPFetcher{BaseURL: baseURL, Manifest: manifest, Client: http.DefaultClient}
+}
+
+func (f *HTTPFetcher) Fetch(ctx context.Context, curve ecc.ID, maxDegree int) ([]byte, error) {
+	entry, err := f.findManifestEntry(curve, maxDegree)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/%s-%d.srs", f.BaseURL, curve.String(), entry.MaxDegree)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not download SRS from %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("SRS server returned status %d for %s", resp.StatusCode, url)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read SRS response body: %w", err)
+	}
+
+	digest := sha256.Sum256(data)
+	if hex.EncodeToString(digest[:]) != entry.Sha256 {
+		return nil, fmt.Errorf("SRS downloaded from %s does not match its pinned manifest digest", url)
+	}
+
+	return data, nil
+}
+
+// loadManifest reads a JSON-encoded list of ManifestEntry values from path, pinning the
+// SHA-256 digests an HTTPFetcher verifies downloaded SRS files against.
+func loadManifest(path string) ([]ManifestEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read manifest file: %w", err)
+	}
+
+	var manifest []ManifestEntry
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("could not parse manifest file: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// findManifestEntry picks the smallest pinned SRS that still covers maxDegree, since a
+// larger ceremony file can always be truncated down.
+func (f *HTTPFetcher) findManifestEntry(curve ecc.ID, maxDegree int) (ManifestEntry, error) {
+	var best *ManifestEntry
+	for i, entry := range f.Manifest {
+		if entry.Curve != curve.String() || entry.MaxDegree < maxDegree {
+			continue
+		}
+		if best == nil || entry.MaxDegree < best.MaxDegree {
+			best = &f.Manifest[i]
+		}
+	}
+	if best == nil {
+		return ManifestEntry{}, fmt.Errorf("no manifest entry covers curve %s at degree %d", curve.String(), maxDegree)
+	}
+	return *best, nil
+}
@@ -0,0 +1,90 @@
+package srs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/fft"
+	kzgbn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr/kzg"
+)
+
+// Provider supplies a KZG SRS sized for a given circuit, on top of a Fetcher. It keeps
+// the largest SRS it has loaded so far in memory and truncates it down for smaller
+// circuits, so a single ceremony-sourced SRS is reused across many proofs instead of
+// being refetched (or, as with unsafekzg.NewSRS, regenerated insecurely) per proof.
+type Provider struct {
+	fetcher Fetcher
+	curve   ecc.ID
+	loaded  *kzgbn254.SRS
+}
+
+// NewProvider returns a Provider for curve, or an error if curve isn't one SRS()
+// knows how to decode. Only BN254 is supported today: every current caller
+// (GenerateIneqProof and its sibling PLONK BN254 circuits) only ever needs a BN254 SRS,
+// and gnark-crypto's KZG SRS types differ per curve, so a Provider can't decode bytes
+// fetched for a curve it wasn't built for.
+func NewProvider(fetcher Fetcher, curve ecc.ID) (*Provider, error) {
+	if curve != ecc.BN254 {
+		return nil, fmt.Errorf("unsupported curve %s: srs.Provider only decodes BN254 SRS files", curve.String())
+	}
+	return &Provider{fetcher: fetcher, curve: curve}, nil
+}
+
+// SRS returns a canonical-basis KZG SRS and its Lagrange-basis counterpart, both sized
+// for a circuit with nbConstraints constraints. The underlying SRS is fetched on first
+// use and again only when a larger one is needed; every call that fits within the
+// already-loaded SRS is served by truncation.
+func (p *Provider) SRS(ctx context.Context, nbConstraints int) (kzgbn254.SRS, kzgbn254.SRS, error) {
+	size := requiredSRSSize(nbConstraints)
+
+	if p.loaded == nil || len(p.loaded.Pk.G1) < size+3 {
+		data, err := p.fetcher.Fetch(ctx, p.curve, size+3)
+		if err != nil {
+			return kzgbn254.SRS{}, kzgbn254.SRS{}, fmt.Errorf("could not fetch SRS: %w", err)
+		}
+
+		var loaded kzgbn254.SRS
+		if _, err := loaded.ReadFrom(bytes.NewReader(data)); err != nil {
+			return kzgbn254.SRS{}, kzgbn254.SRS{}, fmt.Errorf("could not decode SRS: %w", err)
+		}
+		p.loaded = &loaded
+	}
+
+	return truncate(*p.loaded, size)
+}
+
+// requiredSRSSize mirrors the sizing gnark's unsafekzg test helper uses: the Lagrange
+// SRS is the next power of two that is at least nbConstraints, and the canonical SRS
+// is 3 elements longer, the +3 accounting for PLONK's blinding factors.
+func requiredSRSSize(nbConstraints int) int {
+	size := 1
+	for size < nbConstraints {
+		size *= 2
+	}
+	return size
+}
+
+// truncate returns a (size+3)-sized canonical SRS and a size-sized Lagrange SRS derived
+// from full. A KZG SRS is a sequence of powers of tau, so a prefix of a larger SRS is
+// itself a valid smaller SRS; no new trusted setup is needed, only an FFT to rebuild the
+// Lagrange basis for the smaller domain.
+func truncate(full kzgbn254.SRS, size int) (kzgbn254.SRS, kzgbn254.SRS, error) {
+	if len(full.Pk.G1) < size+3 {
+		return kzgbn254.SRS{}, kzgbn254.SRS{}, fmt.Errorf("cached SRS of size %d is smaller than the required size %d", len(full.Pk.G1), size+3)
+	}
+
+	canonical := kzgbn254.SRS{
+		Pk: kzgbn254.ProvingKey{G1: full.Pk.G1[:size+3]},
+		Vk: full.Vk,
+	}
+
+	domain := fft.NewDomain(uint64(size))
+	lagrange := kzgbn254.SRS{
+		Pk: kzgbn254.ProvingKey{G1: kzgbn254.ToLagrangeG1(full.Pk.G1[:size], domain)},
+		Vk: full.Vk,
+	}
+
+	return canonical, lagrange, nil
+}
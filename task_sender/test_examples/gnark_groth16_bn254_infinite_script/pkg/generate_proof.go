@@ -0,0 +1,111 @@
+package pkg
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+)
+
+// InequalityCircuit defines a simple circuit
+// x != 0
+type InequalityCircuit struct {
+	X frontend.Variable `gnark:"x"`
+}
+
+// Define declares the circuit constraints
+// x != 0
+func (circuit *InequalityCircuit) Define(api frontend.API) error {
+	api.AssertIsDifferent(circuit.X, 0)
+	return nil
+}
+
+func GenerateGroth16Proof(x int) {
+	outputDir := "task_sender/test_examples/gnark_groth16_bn254_infinite_script/infinite_proofs/"
+	fmt.Println("Starting GenerateGroth16Proof for x =", x)
+
+	var circuit InequalityCircuit
+	fmt.Println("Compiling circuit...")
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit)
+	if err != nil {
+		panic("circuit compilation error: " + err.Error())
+	}
+	fmt.Println("Circuit compiled successfully.")
+
+	fmt.Println("Running Groth16 setup...")
+	pk, vk, err := groth16.Setup(ccs)
+	if err != nil {
+		panic("Groth16 setup error: " + err.Error())
+	}
+	fmt.Println("Groth16 setup completed.")
+
+	assignment := InequalityCircuit{X: x}
+
+	fmt.Println("Creating full witness...")
+	fullWitness, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField())
+	if err != nil {
+		log.Fatal("Error creating full witness: ", err)
+	}
+	fmt.Println("Full witness created successfully.")
+
+	fmt.Println("Creating public witness...")
+	publicWitness, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField(), frontend.PublicOnly())
+	if err != nil {
+		log.Fatal("Error creating public witness: ", err)
+	}
+	fmt.Println("Public witness created successfully.")
+
+	fmt.Println("Generating proof...")
+	proof, err := groth16.Prove(ccs, pk, fullWitness)
+	if err != nil {
+		panic("Groth16 proof generation error: " + err.Error())
+	}
+	fmt.Println("Proof generated successfully.")
+
+	fmt.Println("Verifying proof...")
+	err = groth16.Verify(proof, vk, publicWitness)
+	if err != nil {
+		panic("Groth16 proof not verified: " + err.Error())
+	}
+	fmt.Println("Proof verified successfully.")
+
+	fmt.Println("Writing proof to file...")
+	proofFile, err := os.Create(outputDir + "ineq_" + strconv.Itoa(x) + "_groth16.proof")
+	if err != nil {
+		panic("Error creating proof file: " + err.Error())
+	}
+	vkFile, err := os.Create(outputDir + "ineq_" + strconv.Itoa(x) + "_groth16.vk")
+	if err != nil {
+		panic("Error creating verification key file: " + err.Error())
+	}
+	witnessFile, err := os.Create(outputDir + "ineq_" + strconv.Itoa(x) + "_groth16.pub")
+	if err != nil {
+		panic("Error creating public witness file: " + err.Error())
+	}
+	defer proofFile.Close()
+	defer vkFile.Close()
+	defer witnessFile.Close()
+
+	_, err = proof.WriteTo(proofFile)
+	if err != nil {
+		panic("Could not serialize proof into file: " + err.Error())
+	}
+	_, err = vk.WriteTo(vkFile)
+	if err != nil {
+		panic("Could not serialize verification key into file: " + err.Error())
+	}
+	_, err = publicWitness.WriteTo(witnessFile)
+	if err != nil {
+		panic("Could not serialize public witness into file: " + err.Error())
+	}
+
+	fmt.Println("Proof written into ineq_" + strconv.Itoa(x) + "_groth16.proof")
+	fmt.Println("Verification key written into ineq_" + strconv.Itoa(x) + "_groth16.vk")
+	fmt.Println("Public witness written into ineq_" + strconv.Itoa(x) + "_groth16.pub")
+	fmt.Println("GenerateGroth16Proof completed successfully for x =", x)
+}
@@ -1,6 +1,7 @@
 package pkg
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -11,7 +12,8 @@ import (
 	cs "github.com/consensys/gnark/constraint/bn254"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/frontend/cs/scs"
-	"github.com/consensys/gnark/test/unsafekzg"
+
+	"github.com/yetanotherco/aligned_layer/pkg/srs"
 )
 
 // InequalityCircuit defines a simple circuit
@@ -27,7 +29,9 @@ func (circuit *InequalityCircuit) Define(api frontend.API) error {
 	return nil
 }
 
-func GenerateIneqProof(x int) {
+// GenerateIneqProof proves the InequalityCircuit for x, reusing srsProvider's cached
+// KZG SRS instead of regenerating an unsafe one per call.
+func GenerateIneqProof(x int, srsProvider *srs.Provider) {
 	outputDir := "task_sender/test_examples/gnark_plonk_bn254_infinite_script/infinite_proofs/"
 	fmt.Println("Starting GenerateIneqProof for x =", x)
 
@@ -42,15 +46,15 @@ func GenerateIneqProof(x int) {
 	r1cs := ccs.(*cs.SparseR1CS)
 	fmt.Printf("Number of constraints: %d\n", r1cs.GetNbConstraints())
 
-	fmt.Println("Generating SRS...")
-	srs, srsLagrangeInterpolation, err := unsafekzg.NewSRS(r1cs) //Here
+	fmt.Println("Loading SRS...")
+	kzgSRS, kzgSRSLagrangeInterpolation, err := srsProvider.SRS(context.Background(), r1cs.GetNbConstraints())
 	if err != nil {
 		panic("KZG setup error: " + err.Error())
 	}
-	fmt.Println("SRS generated successfully.")
+	fmt.Println("SRS loaded successfully.")
 
 	fmt.Println("Setting up PLONK...")
-	pk, vk, err := plonk.Setup(ccs, srs, srsLagrangeInterpolation)
+	pk, vk, err := plonk.Setup(ccs, &kzgSRS, &kzgSRSLagrangeInterpolation)
 	if err != nil {
 		panic("PLONK setup error: " + err.Error())
 	}
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/yetanotherco/aligned_layer/common"
+	verifierpkg "github.com/yetanotherco/aligned_layer/verifier/pkg"
+	verifierv1 "github.com/yetanotherco/aligned_layer/verifier/proto/v1"
+)
+
+// Client is the SDK for the standalone Aligned verifier service, for use by wallets,
+// bridges and other AVSs that want to verify an Aligned-supported proof without running
+// an operator or the AVS subscriber loop.
+type Client struct {
+	httpAddress string
+	httpClient  *http.Client
+	grpcConn    *grpc.ClientConn
+	grpcClient  verifierv1.VerifierClient
+}
+
+// NewHttpClient builds a Client backed by the service's HTTP endpoint at address (e.g.
+// "https://verifier.alignedlayer.com"). insecureSkipVerify should only be set for local
+// testing against a self-signed certificate.
+func NewHttpClient(address string, insecureSkipVerify bool) *Client {
+	transport := &http.Transport{}
+	if insecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	return &Client{
+		httpAddress: address,
+		httpClient:  &http.Client{Transport: transport},
+	}
+}
+
+// NewGrpcClient builds a Client backed by the service's gRPC endpoint at address. When
+// useTLS is false the connection is established in plaintext, which is only
+// appropriate against a local or otherwise trusted endpoint.
+func NewGrpcClient(address string, useTLS bool) (*Client, error) {
+	creds := insecure.NewCredentials()
+	if useTLS {
+		creds = credentials.NewTLS(&tls.Config{})
+	}
+
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("could not dial verifier service at %s: %w", address, err)
+	}
+
+	return &Client{
+		grpcConn:   conn,
+		grpcClient: verifierv1.NewVerifierClient(conn),
+	}, nil
+}
+
+// Verify submits a proof to the verifier service and returns its VerificationResult,
+// including a BLS signature over the result when the serving instance is configured to
+// sign its responses.
+func (c *Client) Verify(ctx context.Context, provingSystemId common.ProvingSystemId, proof, pubInput, verificationKey []byte) (*verifierpkg.VerificationResult, error) {
+	if c.grpcClient != nil {
+		return c.verifyGrpc(ctx, provingSystemId, proof, pubInput, verificationKey)
+	}
+	return c.verifyHttp(ctx, provingSystemId, proof, pubInput, verificationKey)
+}
+
+func (c *Client) verifyGrpc(ctx context.Context, provingSystemId common.ProvingSystemId, proof, pubInput, verificationKey []byte) (*verifierpkg.VerificationResult, error) {
+	resp, err := c.grpcClient.Verify(ctx, &verifierv1.VerificationRequest{
+		ProvingSystemId: uint32(provingSystemId),
+		Proof:           proof,
+		PubInput:        pubInput,
+		VerificationKey: verificationKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &verifierpkg.VerificationResult{Verified: resp.Verified, Signature: resp.Signature}, nil
+}
+
+func (c *Client) verifyHttp(ctx context.Context, provingSystemId common.ProvingSystemId, proof, pubInput, verificationKey []byte) (*verifierpkg.VerificationResult, error) {
+	body, err := json.Marshal(verifierpkg.VerificationRequest{
+		ProvingSystemId: provingSystemId,
+		Proof:           proof,
+		PubInput:        pubInput,
+		VerificationKey: verificationKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not encode verification request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.httpAddress+"/verify", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not reach verifier service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("verifier service returned status %d", resp.StatusCode)
+	}
+
+	var result verifierpkg.VerificationResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("could not decode verification result: %w", err)
+	}
+	return &result, nil
+}
+
+// Close releases the underlying gRPC connection, if any.
+func (c *Client) Close() error {
+	if c.grpcConn != nil {
+		return c.grpcConn.Close()
+	}
+	return nil
+}
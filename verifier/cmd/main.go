@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+
+	verifierpkg "github.com/yetanotherco/aligned_layer/verifier/pkg"
+)
+
+func main() {
+	grpcAddress := flag.String("grpc-address", "0.0.0.0:50051", "address the gRPC server listens on")
+	httpAddress := flag.String("http-address", "0.0.0.0:8080", "address the HTTP server listens on")
+	tlsCertFile := flag.String("tls-cert-file", "", "path to a TLS certificate; when unset both servers serve plaintext")
+	tlsKeyFile := flag.String("tls-key-file", "", "path to the TLS certificate's private key")
+	blsKeystorePath := flag.String("bls-keystore-path", "", "path to an encrypted BLS keystore used to sign verification results; when unset responses are not signed")
+	blsKeystorePassword := flag.String("bls-keystore-password", "", "password for --bls-keystore-path")
+	flag.Parse()
+
+	logger, err := logging.NewZapLogger(logging.Development)
+	if err != nil {
+		log.Fatalf("Could not start logger: %v", err)
+	}
+
+	var signingKey *bls.KeyPair
+	if *blsKeystorePath != "" {
+		signingKey, err = bls.ReadPrivateKeyFromFile(*blsKeystorePath, *blsKeystorePassword)
+		if err != nil {
+			log.Fatalf("Could not read BLS keystore: %v", err)
+		}
+	}
+
+	config := verifierpkg.ServiceConfig{
+		GrpcAddress: *grpcAddress,
+		HttpAddress: *httpAddress,
+		TLSCertFile: *tlsCertFile,
+		TLSKeyFile:  *tlsKeyFile,
+		SigningKey:  signingKey,
+	}
+
+	service := verifierpkg.NewService(config, logger)
+
+	errChan := make(chan error, 2)
+	go func() { errChan <- service.ServeGrpc() }()
+	go func() { errChan <- service.ServeHttp() }()
+
+	if err := <-errChan; err != nil {
+		logger.Fatalf("Verifier service stopped: %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+package pkg
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// buildEthStateProof builds a one-leaf trie containing key -> value, proves key against
+// it, and returns the trie's root along with the eth_getProof-style proof node list.
+func buildEthStateProof(t *testing.T, key, value []byte) (root ethcommon.Hash, proof [][]byte) {
+	t.Helper()
+
+	db := trie.NewDatabase(memorydb.New())
+	tr := trie.NewEmpty(db)
+	if err := tr.Update(key, value); err != nil {
+		t.Fatalf("could not update trie: %v", err)
+	}
+
+	proofDB := memorydb.New()
+	if err := tr.Prove(key, proofDB); err != nil {
+		t.Fatalf("could not build proof: %v", err)
+	}
+
+	it := proofDB.NewIterator(nil, nil)
+	defer it.Release()
+	for it.Next() {
+		node := make([]byte, len(it.Value()))
+		copy(node, it.Value())
+		proof = append(proof, node)
+	}
+
+	return tr.Hash(), proof
+}
+
+func TestVerifyEthStateProof_Account(t *testing.T) {
+	account := ethcommon.HexToAddress("0x000000000000000000000000000000000000aa")
+	accountLeaf, err := rlp.EncodeToBytes(stateAccount{
+		Nonce:    1,
+		Balance:  big.NewInt(100),
+		Root:     ethcommon.Hash{},
+		CodeHash: crypto.Keccak256(nil),
+	})
+	if err != nil {
+		t.Fatalf("could not encode account leaf: %v", err)
+	}
+
+	root, proof := buildEthStateProof(t, crypto.Keccak256(account.Bytes()), accountLeaf)
+
+	header := types.Header{Root: root}
+	payload := EthStateProofPayload{Header: header, AccountProof: proof}
+	proofBytes, err := rlp.EncodeToBytes(payload)
+	if err != nil {
+		t.Fatalf("could not encode payload: %v", err)
+	}
+
+	pubInput := mustMarshalPubInput(t, EthStateProofPubInput{
+		Kind:          EthStateProofAccount,
+		Account:       account,
+		ExpectedValue: accountLeaf,
+	})
+
+	v := NewVerifier()
+	if verified := v.verifyEthStateProof(proofBytes, pubInput, header.Hash().Bytes()); !verified {
+		t.Fatalf("expected a valid account proof to verify")
+	}
+}
+
+func TestVerifyEthStateProof_Account_TamperedProof(t *testing.T) {
+	account := ethcommon.HexToAddress("0x000000000000000000000000000000000000aa")
+	accountLeaf, err := rlp.EncodeToBytes(stateAccount{Nonce: 1, Balance: big.NewInt(100), CodeHash: crypto.Keccak256(nil)})
+	if err != nil {
+		t.Fatalf("could not encode account leaf: %v", err)
+	}
+
+	root, proof := buildEthStateProof(t, crypto.Keccak256(account.Bytes()), accountLeaf)
+	proof[0][0] ^= 0xFF // corrupt a proof node
+
+	header := types.Header{Root: root}
+	payload := EthStateProofPayload{Header: header, AccountProof: proof}
+	proofBytes, err := rlp.EncodeToBytes(payload)
+	if err != nil {
+		t.Fatalf("could not encode payload: %v", err)
+	}
+
+	pubInput := mustMarshalPubInput(t, EthStateProofPubInput{
+		Kind:          EthStateProofAccount,
+		Account:       account,
+		ExpectedValue: accountLeaf,
+	})
+
+	v := NewVerifier()
+	if verified := v.verifyEthStateProof(proofBytes, pubInput, header.Hash().Bytes()); verified {
+		t.Fatalf("expected a tampered proof to fail verification")
+	}
+}
+
+func TestVerifyEthStateProof_Account_WrongExpectedValue(t *testing.T) {
+	account := ethcommon.HexToAddress("0x000000000000000000000000000000000000aa")
+	accountLeaf, err := rlp.EncodeToBytes(stateAccount{Nonce: 1, Balance: big.NewInt(100), CodeHash: crypto.Keccak256(nil)})
+	if err != nil {
+		t.Fatalf("could not encode account leaf: %v", err)
+	}
+
+	root, proof := buildEthStateProof(t, crypto.Keccak256(account.Bytes()), accountLeaf)
+
+	header := types.Header{Root: root}
+	payload := EthStateProofPayload{Header: header, AccountProof: proof}
+	proofBytes, err := rlp.EncodeToBytes(payload)
+	if err != nil {
+		t.Fatalf("could not encode payload: %v", err)
+	}
+
+	pubInput := mustMarshalPubInput(t, EthStateProofPubInput{
+		Kind:          EthStateProofAccount,
+		Account:       account,
+		ExpectedValue: []byte("not the real leaf"),
+	})
+
+	v := NewVerifier()
+	if verified := v.verifyEthStateProof(proofBytes, pubInput, header.Hash().Bytes()); verified {
+		t.Fatalf("expected a mismatched ExpectedValue to fail verification")
+	}
+}
+
+func TestVerifyEthStateProof_Storage(t *testing.T) {
+	slot := ethcommon.HexToHash("0x01")
+	storageValue := []byte("storage-value")
+	storageRoot, storageProof := buildEthStateProof(t, crypto.Keccak256(slot.Bytes()), storageValue)
+
+	account := ethcommon.HexToAddress("0x000000000000000000000000000000000000bb")
+	accountLeaf, err := rlp.EncodeToBytes(stateAccount{
+		Nonce:    1,
+		Balance:  big.NewInt(0),
+		Root:     storageRoot,
+		CodeHash: crypto.Keccak256(nil),
+	})
+	if err != nil {
+		t.Fatalf("could not encode account leaf: %v", err)
+	}
+	accountRoot, accountProof := buildEthStateProof(t, crypto.Keccak256(account.Bytes()), accountLeaf)
+
+	header := types.Header{Root: accountRoot}
+	payload := EthStateProofPayload{Header: header, AccountProof: accountProof, StorageProof: storageProof}
+	proofBytes, err := rlp.EncodeToBytes(payload)
+	if err != nil {
+		t.Fatalf("could not encode payload: %v", err)
+	}
+
+	pubInput := mustMarshalPubInput(t, EthStateProofPubInput{
+		Kind:          EthStateProofStorage,
+		Account:       account,
+		Slot:          slot,
+		ExpectedValue: storageValue,
+	})
+
+	v := NewVerifier()
+	if verified := v.verifyEthStateProof(proofBytes, pubInput, header.Hash().Bytes()); !verified {
+		t.Fatalf("expected a valid storage proof to verify")
+	}
+}
+
+func TestVerifyEthStateProof_Receipt(t *testing.T) {
+	receiptKey, err := rlp.EncodeToBytes(uint(0))
+	if err != nil {
+		t.Fatalf("could not encode receipt key: %v", err)
+	}
+	receiptLeaf := []byte("receipt-leaf")
+	root, proof := buildEthStateProof(t, receiptKey, receiptLeaf)
+
+	header := types.Header{ReceiptHash: root}
+	payload := EthStateProofPayload{Header: header, ReceiptProof: proof}
+	proofBytes, err := rlp.EncodeToBytes(payload)
+	if err != nil {
+		t.Fatalf("could not encode payload: %v", err)
+	}
+
+	pubInput := mustMarshalPubInput(t, EthStateProofPubInput{
+		Kind:          EthStateProofReceipt,
+		ReceiptKey:    receiptKey,
+		ExpectedValue: receiptLeaf,
+	})
+
+	v := NewVerifier()
+	if verified := v.verifyEthStateProof(proofBytes, pubInput, header.Hash().Bytes()); !verified {
+		t.Fatalf("expected a valid receipt proof to verify")
+	}
+}
+
+func mustMarshalPubInput(t *testing.T, pubInput EthStateProofPubInput) []byte {
+	t.Helper()
+	b, err := json.Marshal(pubInput)
+	if err != nil {
+		t.Fatalf("could not marshal pub input: %v", err)
+	}
+	return b
+}
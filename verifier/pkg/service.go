@@ -0,0 +1,176 @@
+package pkg
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+	"golang.org/x/crypto/sha3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/yetanotherco/aligned_layer/common"
+	verifierv1 "github.com/yetanotherco/aligned_layer/verifier/proto/v1"
+)
+
+// ServiceConfig configures a standalone verifier Service.
+type ServiceConfig struct {
+	GrpcAddress string
+	HttpAddress string
+	// TLSCertFile / TLSKeyFile enable TLS on both listeners when set. If left empty
+	// both listeners serve plaintext, which is only appropriate behind a TLS-terminating
+	// proxy.
+	TLSCertFile string
+	TLSKeyFile  string
+	// SigningKey, when set, is used to BLS-sign every VerificationResult so a caller can
+	// attest the response came from a known verifier instance.
+	SigningKey *bls.KeyPair
+}
+
+// Service is the standalone proof-verification microservice. It wraps a Verifier and
+// exposes it over gRPC and HTTP, so clients that are not operators (wallets, bridges,
+// other AVSs) can verify Aligned-supported proofs without running the AVS subscriber
+// loop.
+type Service struct {
+	verifierv1.UnimplementedVerifierServer
+	config   ServiceConfig
+	verifier *Verifier
+	logger   logging.Logger
+}
+
+func NewService(config ServiceConfig, logger logging.Logger) *Service {
+	return &Service{
+		config:   config,
+		verifier: NewVerifier(),
+		logger:   logger,
+	}
+}
+
+// Verify implements the VerifierServer gRPC interface.
+func (s *Service) Verify(_ context.Context, req *verifierv1.VerificationRequest) (*verifierv1.VerificationResult, error) {
+	result, err := s.verify(VerificationRequest{
+		ProvingSystemId: common.ProvingSystemId(req.ProvingSystemId),
+		Proof:           req.Proof,
+		PubInput:        req.PubInput,
+		VerificationKey: req.VerificationKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &verifierv1.VerificationResult{
+		Verified:  result.Verified,
+		Signature: result.Signature,
+	}, nil
+}
+
+func (s *Service) verify(req VerificationRequest) (*VerificationResult, error) {
+	verified, err := s.verifier.Verify(req.toVerificationData())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &VerificationResult{Verified: verified}
+	if s.config.SigningKey != nil {
+		digest := digestVerificationResult(req, verified)
+		signature := s.config.SigningKey.SignMessage(digest)
+		result.Signature = signature.Marshal()
+	}
+	return result, nil
+}
+
+func digestVerificationResult(req VerificationRequest, verified bool) [32]byte {
+	hasher := sha3.NewLegacyKeccak256()
+	var provingSystemId [2]byte
+	binary.BigEndian.PutUint16(provingSystemId[:], uint16(req.ProvingSystemId))
+	hasher.Write(provingSystemId[:])
+	hasher.Write(req.Proof)
+	hasher.Write(req.PubInput)
+	hasher.Write(req.VerificationKey)
+	if verified {
+		hasher.Write([]byte{1})
+	} else {
+		hasher.Write([]byte{0})
+	}
+
+	var digest [32]byte
+	copy(digest[:], hasher.Sum(nil)[:32])
+	return digest
+}
+
+func (s *Service) serveHttp(handler http.Handler) (net.Listener, *http.Server, error) {
+	listener, err := net.Listen("tcp", s.config.HttpAddress)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not listen on %s: %w", s.config.HttpAddress, err)
+	}
+	return listener, &http.Server{Handler: handler}, nil
+}
+
+// ServeHttp handles a single `POST /verify` endpoint accepting a JSON-encoded
+// VerificationRequest and returning a JSON-encoded VerificationResult.
+func (s *Service) ServeHttp() error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/verify", func(w http.ResponseWriter, r *http.Request) {
+		var req VerificationRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		result, err := s.verify(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			s.logger.Errorf("Could not write verification result: %v", err)
+		}
+	})
+
+	listener, server, err := s.serveHttp(mux)
+	if err != nil {
+		return err
+	}
+
+	if s.config.TLSCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(s.config.TLSCertFile, s.config.TLSKeyFile)
+		if err != nil {
+			return fmt.Errorf("could not load TLS certificate: %w", err)
+		}
+		listener = tls.NewListener(listener, &tls.Config{Certificates: []tls.Certificate{cert}})
+	}
+
+	s.logger.Infof("Verifier HTTP service listening on %s", s.config.HttpAddress)
+	return server.Serve(listener)
+}
+
+// ServeGrpc starts the gRPC listener. It blocks until the server stops or returns an
+// error.
+func (s *Service) ServeGrpc() error {
+	listener, err := net.Listen("tcp", s.config.GrpcAddress)
+	if err != nil {
+		return fmt.Errorf("could not listen on %s: %w", s.config.GrpcAddress, err)
+	}
+
+	var opts []grpc.ServerOption
+	if s.config.TLSCertFile != "" {
+		creds, err := credentials.NewServerTLSFromFile(s.config.TLSCertFile, s.config.TLSKeyFile)
+		if err != nil {
+			return fmt.Errorf("could not load TLS credentials: %w", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	grpcServer := grpc.NewServer(opts...)
+	verifierv1.RegisterVerifierServer(grpcServer, s)
+
+	s.logger.Infof("Verifier gRPC service listening on %s", s.config.GrpcAddress)
+	return grpcServer.Serve(listener)
+}
@@ -0,0 +1,31 @@
+package pkg
+
+import "github.com/yetanotherco/aligned_layer/common"
+
+// VerificationRequest is the JSON/gRPC wire shape clients send to the standalone
+// verifier service. Proof, PubInput and VerificationKey are base64-encoded on the wire
+// by the standard Go JSON codec, so callers don't need a separate encoding scheme.
+type VerificationRequest struct {
+	ProvingSystemId common.ProvingSystemId `json:"provingSystemId"`
+	Proof           []byte                 `json:"proof"`
+	PubInput        []byte                 `json:"pubInput"`
+	VerificationKey []byte                 `json:"verificationKey"`
+}
+
+// VerificationResult is the response returned for a VerificationRequest. When the
+// service is configured with a BLS key, Signature is the BLS signature over the
+// keccak256 digest of (ProvingSystemId || Proof || PubInput || VerificationKey ||
+// Verified), letting a caller attest the result came from a known verifier instance.
+type VerificationResult struct {
+	Verified  bool   `json:"verified"`
+	Signature []byte `json:"signature,omitempty"`
+}
+
+func (r VerificationRequest) toVerificationData() VerificationData {
+	return VerificationData{
+		ProvingSystemId: r.ProvingSystemId,
+		Proof:           r.Proof,
+		PubInput:        r.PubInput,
+		VerificationKey: r.VerificationKey,
+	}
+}
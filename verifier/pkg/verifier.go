@@ -0,0 +1,147 @@
+package pkg
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/plonk"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/yetanotherco/aligned_layer/common"
+	"github.com/yetanotherco/aligned_layer/operator/sp1"
+)
+
+// VerificationData is everything needed to check a single proof, independent of how
+// it arrived (a batch task picked up by an operator, or a request made directly to the
+// standalone verifier service).
+type VerificationData struct {
+	ProvingSystemId common.ProvingSystemId
+	Proof           []byte
+	PubInput        []byte
+	VerificationKey []byte
+}
+
+// Verifier implements proof verification for every ProvingSystemId Aligned supports.
+// It holds no state of its own; it exists so the operator and the standalone verifier
+// service can share one implementation instead of duplicating the switch on
+// ProvingSystemId.
+type Verifier struct{}
+
+func NewVerifier() *Verifier {
+	return &Verifier{}
+}
+
+// Verify checks data.Proof against data.PubInput and data.VerificationKey using the
+// proving system identified by data.ProvingSystemId. It returns an error only when the
+// request itself can't be processed (e.g. an unrecognized ProvingSystemId); a
+// well-formed but invalid proof is reported as (false, nil).
+func (v *Verifier) Verify(data VerificationData) (bool, error) {
+	switch data.ProvingSystemId {
+	case common.GnarkPlonkBls12_381:
+		return v.verifyPlonkProof(data.Proof, data.PubInput, data.VerificationKey, ecc.BLS12_381), nil
+
+	case common.GnarkPlonkBn254:
+		return v.verifyPlonkProof(data.Proof, data.PubInput, data.VerificationKey, ecc.BN254), nil
+
+	case common.GnarkGroth16Bls12_381:
+		return v.verifyGroth16Proof(data.Proof, data.PubInput, data.VerificationKey, ecc.BLS12_381), nil
+
+	case common.GnarkGroth16Bn254:
+		return v.verifyGroth16Proof(data.Proof, data.PubInput, data.VerificationKey, ecc.BN254), nil
+
+	case common.EthStateProof:
+		return v.verifyEthStateProof(data.Proof, data.PubInput, data.VerificationKey), nil
+
+	case common.SP1:
+		proofLen := (uint)(len(data.Proof))
+		proofBytes := make([]byte, sp1.MaxProofSize)
+		copy(proofBytes, data.Proof)
+
+		elf := data.PubInput
+		elfBytes := make([]byte, sp1.MaxElfBufferSize)
+		copy(elfBytes, elf)
+		elfLen := (uint)(len(elf))
+
+		return sp1.VerifySp1Proof(([sp1.MaxProofSize]byte)(proofBytes), proofLen, ([sp1.MaxElfBufferSize]byte)(elfBytes), elfLen), nil
+
+	default:
+		return false, fmt.Errorf("unrecognized proving system id: %d", data.ProvingSystemId)
+	}
+}
+
+// VerifyPlonkProofBLS12_381 verifies a BLS12-381 PLONK proof.
+func (v *Verifier) VerifyPlonkProofBLS12_381(proofBytes []byte, pubInputBytes []byte, verificationKeyBytes []byte) bool {
+	return v.verifyPlonkProof(proofBytes, pubInputBytes, verificationKeyBytes, ecc.BLS12_381)
+}
+
+// VerifyPlonkProofBN254 verifies a BN254 PLONK proof.
+func (v *Verifier) VerifyPlonkProofBN254(proofBytes []byte, pubInputBytes []byte, verificationKeyBytes []byte) bool {
+	return v.verifyPlonkProof(proofBytes, pubInputBytes, verificationKeyBytes, ecc.BN254)
+}
+
+// verifyPlonkProof contains the common PLONK proof verification logic, shared by both
+// curves.
+func (v *Verifier) verifyPlonkProof(proofBytes []byte, pubInputBytes []byte, verificationKeyBytes []byte, curve ecc.ID) bool {
+	proofReader := bytes.NewReader(proofBytes)
+	proof := plonk.NewProof(curve)
+	if _, err := proof.ReadFrom(proofReader); err != nil {
+		return false
+	}
+
+	pubInputReader := bytes.NewReader(pubInputBytes)
+	pubInput, err := witness.New(curve.ScalarField())
+	if err != nil {
+		return false
+	}
+	if _, err = pubInput.ReadFrom(pubInputReader); err != nil {
+		return false
+	}
+
+	verificationKeyReader := bytes.NewReader(verificationKeyBytes)
+	verificationKey := plonk.NewVerifyingKey(curve)
+	if _, err = verificationKey.ReadFrom(verificationKeyReader); err != nil {
+		return false
+	}
+
+	err = plonk.Verify(proof, verificationKey, pubInput)
+	return err == nil
+}
+
+// VerifyGroth16ProofBLS12_381 verifies a BLS12-381 Groth16 proof.
+func (v *Verifier) VerifyGroth16ProofBLS12_381(proofBytes []byte, pubInputBytes []byte, verificationKeyBytes []byte) bool {
+	return v.verifyGroth16Proof(proofBytes, pubInputBytes, verificationKeyBytes, ecc.BLS12_381)
+}
+
+// VerifyGroth16ProofBN254 verifies a BN254 Groth16 proof.
+func (v *Verifier) VerifyGroth16ProofBN254(proofBytes []byte, pubInputBytes []byte, verificationKeyBytes []byte) bool {
+	return v.verifyGroth16Proof(proofBytes, pubInputBytes, verificationKeyBytes, ecc.BN254)
+}
+
+// verifyGroth16Proof contains the common Groth16 proof verification logic, shared by
+// both curves.
+func (v *Verifier) verifyGroth16Proof(proofBytes []byte, pubInputBytes []byte, verificationKeyBytes []byte, curve ecc.ID) bool {
+	proofReader := bytes.NewReader(proofBytes)
+	proof := groth16.NewProof(curve)
+	if _, err := proof.ReadFrom(proofReader); err != nil {
+		return false
+	}
+
+	pubInputReader := bytes.NewReader(pubInputBytes)
+	pubInput, err := witness.New(curve.ScalarField())
+	if err != nil {
+		return false
+	}
+	if _, err = pubInput.ReadFrom(pubInputReader); err != nil {
+		return false
+	}
+
+	verificationKeyReader := bytes.NewReader(verificationKeyBytes)
+	verificationKey := groth16.NewVerifyingKey(curve)
+	if _, err = verificationKey.ReadFrom(verificationKeyReader); err != nil {
+		return false
+	}
+
+	err = groth16.Verify(proof, verificationKey, pubInput)
+	return err == nil
+}
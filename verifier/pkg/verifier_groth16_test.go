@@ -0,0 +1,113 @@
+package pkg
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+
+	"github.com/yetanotherco/aligned_layer/common"
+)
+
+// inequalityCircuit proves x != 0, like the InequalityCircuit used by the
+// gnark_groth16_bn254 infinite script, except that x is public here so a proof can be
+// checked against a public input other than the one it was generated for.
+type inequalityCircuit struct {
+	X frontend.Variable `gnark:"x,public"`
+}
+
+func (circuit *inequalityCircuit) Define(api frontend.API) error {
+	api.AssertIsDifferent(circuit.X, 0)
+	return nil
+}
+
+// buildGroth16IneqProof compiles and proves inequalityCircuit for x, returning
+// gnark-encoded proof/pubInput/verificationKey bytes exactly as they'd arrive in a
+// VerificationData from the task pipeline.
+func buildGroth16IneqProof(t *testing.T, x int) (proof, pubInput, verificationKey []byte) {
+	t.Helper()
+
+	var circuit inequalityCircuit
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit)
+	if err != nil {
+		t.Fatalf("could not compile circuit: %v", err)
+	}
+
+	pk, vk, err := groth16.Setup(ccs)
+	if err != nil {
+		t.Fatalf("could not run Groth16 setup: %v", err)
+	}
+
+	assignment := inequalityCircuit{X: x}
+	fullWitness, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField())
+	if err != nil {
+		t.Fatalf("could not create full witness: %v", err)
+	}
+	publicWitness, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField(), frontend.PublicOnly())
+	if err != nil {
+		t.Fatalf("could not create public witness: %v", err)
+	}
+
+	proofValue, err := groth16.Prove(ccs, pk, fullWitness)
+	if err != nil {
+		t.Fatalf("could not generate proof: %v", err)
+	}
+
+	var proofBuf, pubInputBuf, vkBuf bytes.Buffer
+	if _, err := proofValue.WriteTo(&proofBuf); err != nil {
+		t.Fatalf("could not serialize proof: %v", err)
+	}
+	if _, err := publicWitness.WriteTo(&pubInputBuf); err != nil {
+		t.Fatalf("could not serialize public witness: %v", err)
+	}
+	if _, err := vk.WriteTo(&vkBuf); err != nil {
+		t.Fatalf("could not serialize verification key: %v", err)
+	}
+
+	return proofBuf.Bytes(), pubInputBuf.Bytes(), vkBuf.Bytes()
+}
+
+// TestVerifier_Groth16RoundTrip submits a Groth16 proof through the same
+// VerificationData path an operator uses to verify a task's proof, as if it had arrived
+// over the task pipeline.
+func TestVerifier_Groth16RoundTrip(t *testing.T) {
+	proof, pubInput, verificationKey := buildGroth16IneqProof(t, 42)
+
+	v := NewVerifier()
+	verified, err := v.Verify(VerificationData{
+		ProvingSystemId: common.GnarkGroth16Bn254,
+		Proof:           proof,
+		PubInput:        pubInput,
+		VerificationKey: verificationKey,
+	})
+	if err != nil {
+		t.Fatalf("Verify returned an error: %v", err)
+	}
+	if !verified {
+		t.Fatal("expected a valid Groth16 proof to verify")
+	}
+}
+
+// TestVerifier_Groth16RoundTrip_WrongPubInput checks that a Groth16 proof is rejected,
+// rather than erroring, when paired with a public input it wasn't generated for.
+func TestVerifier_Groth16RoundTrip_WrongPubInput(t *testing.T) {
+	proof, _, verificationKey := buildGroth16IneqProof(t, 42)
+	_, otherPubInput, _ := buildGroth16IneqProof(t, 7)
+
+	v := NewVerifier()
+	verified, err := v.Verify(VerificationData{
+		ProvingSystemId: common.GnarkGroth16Bn254,
+		Proof:           proof,
+		PubInput:        otherPubInput,
+		VerificationKey: verificationKey,
+	})
+	if err != nil {
+		t.Fatalf("Verify returned an error: %v", err)
+	}
+	if verified {
+		t.Fatal("expected a proof paired with the wrong public input to fail verification")
+	}
+}